@@ -0,0 +1,90 @@
+package cron
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// JobWrapper decorates the given Job with some behavior.
+type JobWrapper func(Job) Job
+
+// Chain is a sequence of JobWrappers that decorates submitted jobs with
+// cross-cutting behaviors like logging or synchronization.
+type Chain struct {
+	wrappers []JobWrapper
+}
+
+// NewChain returns a Chain consisting of the given JobWrappers.
+func NewChain(c ...JobWrapper) Chain {
+	return Chain{c}
+}
+
+// Then decorates the given job with all JobWrappers in the chain.
+//
+// This:
+//     NewChain(m1, m2, m3).Then(job)
+// is equivalent to:
+//     m1(m2(m3(job)))
+//
+// A Chain can be safely reused between multiple Then calls.
+func (c Chain) Then(j Job) Job {
+	for i := range c.wrappers {
+		j = c.wrappers[len(c.wrappers)-i-1](j)
+	}
+	return j
+}
+
+// Recover panics in wrapped jobs and reports them to the given logger.
+func Recover(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		return FuncJob(func(args ...interface{}) {
+			defer func() {
+				if r := recover(); r != nil {
+					const size = 64 << 10
+					buf := make([]byte, size)
+					buf = buf[:runtime.Stack(buf, false)]
+					logger.Error(fmt.Errorf("%v", r), "panic running job", "stack", "...\n"+string(buf))
+				}
+			}()
+			j.Run(args...)
+		})
+	}
+}
+
+// SkipIfStillRunning skips an invocation of the job if a prior invocation is
+// still running. It logs skips to the given logger at Info level.
+func SkipIfStillRunning(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		ch := make(chan struct{}, 1)
+		ch <- struct{}{}
+		return FuncJob(func(args ...interface{}) {
+			select {
+			case v := <-ch:
+				defer func() { ch <- v }()
+				j.Run(args...)
+			default:
+				logger.Info("skip", "reason", "still running since previous invocation")
+			}
+		})
+	}
+}
+
+// DelayIfStillRunning serializes jobs, delaying subsequent runs until the
+// previous one is complete. Jobs after the first are delayed, not dropped.
+// Delays beyond a second are logged to the given logger at Info level.
+func DelayIfStillRunning(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		var mu sync.Mutex
+		return FuncJob(func(args ...interface{}) {
+			start := time.Now()
+			mu.Lock()
+			defer mu.Unlock()
+			if dur := time.Since(start); dur > time.Second {
+				logger.Info("delay", "duration", dur)
+			}
+			j.Run(args...)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package cron
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestChainThenOrdersWrappersOutermostFirst covers the documented
+// NewChain(m1, m2, m3).Then(job) == m1(m2(m3(job))) ordering: m1 must see
+// control before m2, and m2 before m3/job.
+func TestChainThenOrdersWrappersOutermostFirst(t *testing.T) {
+	var order []string
+	record := func(name string) JobWrapper {
+		return func(j Job) Job {
+			return FuncJob(func(args ...interface{}) {
+				order = append(order, name)
+				j.Run(args...)
+			})
+		}
+	}
+
+	job := FuncJob(func(...interface{}) { order = append(order, "job") })
+	NewChain(record("m1"), record("m2"), record("m3")).Then(job).Run()
+
+	want := []string{"m1", "m2", "m3", "job"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+// TestRecoverReportsPanicAndDoesNotPropagate covers Recover's two jobs: the
+// panic must reach the Logger, and it must not escape Run to crash the
+// dispatching goroutine.
+func TestRecoverReportsPanicAndDoesNotPropagate(t *testing.T) {
+	logger := &recordingLogger{}
+	job := Recover(logger)(FuncJob(func(...interface{}) {
+		panic("boom")
+	}))
+
+	job.Run()
+
+	if logger.errCalls != 1 {
+		t.Fatalf("Error called %d times, want 1", logger.errCalls)
+	}
+}
+
+// TestSkipIfStillRunningSkipsConcurrentInvocation covers the wrapper's
+// whole point: a second invocation arriving while the first is still
+// running must be skipped, not queued or run concurrently.
+func TestSkipIfStillRunningSkipsConcurrentInvocation(t *testing.T) {
+	logger := &recordingLogger{}
+	release := make(chan struct{})
+	var running int32
+
+	job := SkipIfStillRunning(logger)(FuncJob(func(...interface{}) {
+		atomic.AddInt32(&running, 1)
+		<-release
+		atomic.AddInt32(&running, -1)
+	}))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job.Run()
+	}()
+
+	for atomic.LoadInt32(&running) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// This invocation should be skipped immediately rather than blocking.
+	job.Run()
+	close(release)
+	wg.Wait()
+
+	if logger.infoCalls != 1 {
+		t.Fatalf("Info called %d times, want 1 (the skip)", logger.infoCalls)
+	}
+}
+
+// TestDelayIfStillRunningSerializesInvocations covers the opposite of
+// SkipIfStillRunning: a second invocation must wait for the first to
+// finish rather than being dropped.
+func TestDelayIfStillRunningSerializesInvocations(t *testing.T) {
+	var running int32
+	var overlapped bool
+
+	job := DelayIfStillRunning(DiscardLogger)(FuncJob(func(...interface{}) {
+		if atomic.AddInt32(&running, 1) > 1 {
+			overlapped = true
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job.Run()
+		}()
+	}
+	wg.Wait()
+
+	if overlapped {
+		t.Fatal("DelayIfStillRunning let two invocations run concurrently")
+	}
+}
+
+// recordingLogger counts Info/Error calls so tests can assert a wrapper
+// logged exactly the calls it's documented to.
+type recordingLogger struct {
+	infoCalls int
+	errCalls  int
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) { l.infoCalls++ }
+func (l *recordingLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.errCalls++
+}
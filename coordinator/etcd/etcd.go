@@ -0,0 +1,53 @@
+// Package etcd provides a cron.Coordinator backed by an etcd lease, so at
+// most one replica wins a given tick.
+package etcd
+
+import (
+	"context"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/wiwii/cron"
+)
+
+// keyPrefix namespaces lock keys under a common etcd directory.
+const keyPrefix = "cron/lock/"
+
+// Coordinator is a cron.Coordinator backed by an etcd client.
+type Coordinator struct {
+	Client *clientv3.Client
+}
+
+// New returns a Coordinator that arbitrates ticks through client.
+func New(client *clientv3.Client) *Coordinator {
+	return &Coordinator{Client: client}
+}
+
+// Acquire implements cron.Coordinator via a short-lived etcd lease, granted
+// only if no other replica currently holds the key.
+func (c *Coordinator) Acquire(ctx context.Context, entryKey string, ttl time.Duration) (bool, func(), error) {
+	key := keyPrefix + entryKey
+	lease, err := c.Client.Grant(ctx, int64(ttl.Seconds()))
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := c.Client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, "", clientv3.WithLease(lease.ID))).
+		Commit()
+	if err != nil {
+		return false, nil, err
+	}
+	if !resp.Succeeded {
+		return false, nil, nil
+	}
+
+	release := func() {
+		c.Client.Revoke(context.Background(), lease.ID)
+	}
+	return true, release, nil
+}
+
+var _ cron.Coordinator = (*Coordinator)(nil)
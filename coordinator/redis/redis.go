@@ -0,0 +1,72 @@
+// Package redis provides a cron.Coordinator backed by Redis, using SETNX
+// with an expiry as the mutual-exclusion primitive so at most one replica
+// wins a given tick.
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/wiwii/cron"
+)
+
+// keyPrefix namespaces lock keys so they're easy to spot in `redis-cli keys`.
+const keyPrefix = "cron:lock:"
+
+// releaseScript deletes a lock key only if it still holds the token this
+// Acquire call set. Without this check, a job that outlives leaderLockTTL
+// would have its lock reclaimed by another replica, and this replica's
+// eventual release() would delete that replica's still-active lock instead
+// of its own expired one.
+var releaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// Coordinator is a cron.Coordinator backed by a Redis client.
+type Coordinator struct {
+	Client *redis.Client
+}
+
+// New returns a Coordinator that arbitrates ticks through client.
+func New(client *redis.Client) *Coordinator {
+	return &Coordinator{Client: client}
+}
+
+// Acquire implements cron.Coordinator via a Redis SETNX, fenced with a
+// per-call token so release only ever removes the lock this call itself
+// acquired.
+func (c *Coordinator) Acquire(ctx context.Context, entryKey string, ttl time.Duration) (bool, func(), error) {
+	key := keyPrefix + entryKey
+	token, err := newToken()
+	if err != nil {
+		return false, nil, err
+	}
+
+	ok, err := c.Client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return false, nil, err
+	}
+	release := func() {
+		releaseScript.Run(context.Background(), c.Client, []string{key}, token)
+	}
+	return ok, release, nil
+}
+
+// newToken returns a random value unique enough to fence a single Acquire
+// call's lock against everyone else's.
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+var _ cron.Coordinator = (*Coordinator)(nil)
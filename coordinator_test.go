@@ -0,0 +1,37 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTickKeyUniquePerTick covers tickKey combining LeaderKey and the tick
+// time, so the same entry's successive ticks (and distinct entries sharing
+// no LeaderKey) don't collide.
+func TestTickKeyUniquePerTick(t *testing.T) {
+	t1 := time.Unix(100, 0)
+	t2 := time.Unix(200, 0)
+
+	if tickKey("job-a", t1) == tickKey("job-a", t2) {
+		t.Fatalf("tickKey collided across distinct ticks of the same entry: %q", tickKey("job-a", t1))
+	}
+	if tickKey("job-a", t1) == tickKey("job-b", t1) {
+		t.Fatalf("tickKey collided across distinct entries: %q", tickKey("job-a", t1))
+	}
+}
+
+// TestTickKeySameAcrossReplicas covers the replica-safety property the
+// LeaderKey design exists for: two independently-constructed Entry values
+// (as if registered by two separate replica processes) with the same
+// caller-supplied LeaderKey and the same tick produce the same tickKey,
+// regardless of their (replica-local, unsynchronized) EntryIDs.
+func TestTickKeySameAcrossReplicas(t *testing.T) {
+	tick := time.Unix(100, 0)
+	replicaA := &Entry{Id: 1, LeaderKey: "daily-report"}
+	replicaB := &Entry{Id: 7, LeaderKey: "daily-report"}
+
+	if tickKey(replicaA.LeaderKey, tick) != tickKey(replicaB.LeaderKey, tick) {
+		t.Fatalf("tickKey differed across replicas for the same LeaderKey/tick: %q vs %q",
+			tickKey(replicaA.LeaderKey, tick), tickKey(replicaB.LeaderKey, tick))
+	}
+}
@@ -1,27 +1,53 @@
 package cron
 
 import (
-	"log"
-	"runtime"
-	"sort"
+	"container/heap"
+	"context"
+	"sync"
 	"time"
 )
 
 // Cron keeps track of any number of entries, invoking the associated func as
 // specified by the schedule. It may be started, stopped, and the entries may
 // be inspected while running.
+//
+// All access to entries/byId/id/running/cancel/done goes through mu, so
+// AddJob, Remove, Entries and the run loop itself can be called concurrently
+// from any goroutine without racing.
 type Cron struct {
-	id       int32
-	entries  []*Entry
-	stop     chan struct{}
-	add      chan *Entry
-	snapshot chan []*Entry
-	remove    chan int32
-	running  bool
-	ErrorLog *log.Logger
-	location *time.Location
+	mu      sync.Mutex
+	entries entryHeap
+	byId    map[EntryID]*Entry
+	id      EntryID
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	// wakeup signals the run loop that entries changed (add/remove) so it
+	// should recompute its sleep instead of waiting out a stale timer. It's
+	// buffered by one and drained with a non-blocking send, so poking it
+	// before Start/Run is observed, or while the loop is started, is safe.
+	wakeup chan struct{}
+
+	// jobWg tracks in-flight job goroutines, so Stop's returned context can
+	// be canceled only once every dispatched job has actually finished.
+	jobWg sync.WaitGroup
+
+	logger          Logger
+	location        *time.Location
+	chain           Chain
+	hasChain        bool
+	parser          ScheduleParser
+	store           Store
+	missedRunPolicy MissedRunPolicy
+	jobFactories    map[string]func() Job
+	coordinator     Coordinator
 }
 
+// EntryID identifies an Entry within a Cron, returned by AddJob/Schedule and
+// used to look up or remove that entry later.
+type EntryID int
+
 // Job is an interface for submitted cron jobs.
 type Job interface {
 	Run(...interface{})
@@ -39,8 +65,10 @@ type Entry struct {
 	// The schedule on which this job should be run.
 	Schedule Schedule
 
-	// The next time the job will run. This is the zero time if Cron has not been
-	// started or this entry's schedule is unsatisfiable
+	// The next time the job will run, computed as soon as the entry is
+	// added (Start/Run need not have been called yet) and kept up to date
+	// by the run loop thereafter. It's the zero time only if the schedule
+	// itself is unsatisfiable.
 	Next time.Time
 
 	// The last time this job was run. This is the zero time if the job has never
@@ -50,8 +78,13 @@ type Entry struct {
 	// The Job to run.
 	Job Job
 
+	// WrappedJob is the Job to actually invoke, decorated with the Cron's
+	// job chain (panic recovery by default). This is what the run loop
+	// dispatches, while Job remains the entry as submitted by the caller.
+	WrappedJob Job
+
 	// entry id
-	Id int32
+	Id EntryID
 
 	// args
 	ArgLen int32
@@ -62,47 +95,90 @@ type Entry struct {
 	//
 	Task string
 
-	// 
+	//
 	Params string
+
+	// LeaderOnly skips this entry's tick unless the Cron's Coordinator
+	// grants this node leadership for it. Ignored if the Cron has no
+	// Coordinator configured.
+	LeaderOnly bool
+
+	// LeaderKey identifies this entry to the Coordinator when LeaderOnly is
+	// set. It must be the same on every replica that registers this entry;
+	// see WithEntryLeaderOnly.
+	LeaderKey string
+
+	// heapIndex is this entry's position in the Cron's entries heap, kept
+	// up to date by entryHeap so Remove can evict it in O(log n) instead of
+	// scanning. It's -1 for detached copies (e.g. from Entries/Entry).
+	heapIndex int
 }
 
-// byTime is a wrapper for sorting the entry array by time
-// (with zero time at the end).
-type byTime []*Entry
+// entryHeap is a container/heap min-heap of *Entry ordered by Next, with
+// the zero time sorted last.
+type entryHeap []*Entry
 
-func (s byTime) Len() int      { return len(s) }
-func (s byTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
-func (s byTime) Less(i, j int) bool {
+func (h entryHeap) Len() int { return len(h) }
+
+func (h entryHeap) Less(i, j int) bool {
 	// Two zero times should return false.
 	// Otherwise, zero is "greater" than any other time.
 	// (To sort it at the end of the list.)
-	if s[i].Next.IsZero() {
+	if h[i].Next.IsZero() {
 		return false
 	}
-	if s[j].Next.IsZero() {
+	if h[j].Next.IsZero() {
 		return true
 	}
-	return s[i].Next.Before(s[j].Next)
+	return h[i].Next.Before(h[j].Next)
 }
 
-// New returns a new Cron job runner, in the Local time zone.
-func New() *Cron {
-	return NewWithLocation(time.Now().Location())
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
 }
 
-// NewWithLocation returns a new Cron job runner.
-func NewWithLocation(location *time.Location) *Cron {
-	return &Cron{
+func (h *entryHeap) Push(x interface{}) {
+	e := x.(*Entry)
+	e.heapIndex = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.heapIndex = -1
+	*h = old[:n-1]
+	return e
+}
+
+// New returns a new Cron job runner, in the Local time zone, modified by
+// the given options.
+func New(opts ...Option) *Cron {
+	c := &Cron{
 		id:       0,
-		entries:  nil,
-		add:      make(chan *Entry),
-		stop:     make(chan struct{}),
-		snapshot: make(chan []*Entry),
-		remove:   make(chan int32),
-		running:  false,
-		ErrorLog: nil,
-		location: location,
+		byId:     make(map[EntryID]*Entry),
+		wakeup:   make(chan struct{}, 1),
+		logger:   DefaultLogger,
+		location: time.Now().Location(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if !c.hasChain {
+		c.chain = NewChain(Recover(c.logger))
+	}
+	return c
+}
+
+// NewWithLocation returns a new Cron job runner in the given location.
+//
+// Deprecated: use New(WithLocation(location)) instead.
+func NewWithLocation(location *time.Location) *Cron {
+	return New(WithLocation(location))
 }
 
 // A wrapper that turns a func() into a cron.Job
@@ -110,32 +186,36 @@ type FuncJob func(...interface{})
 
 // func (f FuncJob) Run() { f() }
 
-func (f FuncJob) Run(i...interface{}) { f(i...) }
+func (f FuncJob) Run(i ...interface{}) { f(i...) }
 
 // AddFunc adds a func to the Cron to be run on the given schedule.
 func (c *Cron) AddFunc(spec string, cmd func(...interface{})) error {
 	return c.AddJob(spec, FuncJob(cmd))
 }
 
+// Deprecated: use AddJobOpts(spec, cmd, WithEntryArgLen(n)) instead.
 func (c *Cron) AddFunc3(spec string, cmd func(...interface{}), n int32) error {
-	return c.AddJob(spec, FuncJob(cmd), n)
+	return c.AddJobOpts(spec, FuncJob(cmd), WithEntryArgLen(n))
 }
 
+// Deprecated: use AddJobOpts(spec, cmd, WithEntryArgLen(n), WithEntryTag(tag)) instead.
 func (c *Cron) AddFunc4(spec string, cmd func(...interface{}), n int32, tag string) error {
-	return c.AddJob(spec, FuncJob(cmd), n, tag)
+	return c.AddJobOpts(spec, FuncJob(cmd), WithEntryArgLen(n), WithEntryTag(tag))
 }
 
+// Deprecated: use AddJobOpts with WithEntryArgLen/WithEntryTag/WithEntryTask instead.
 func (c *Cron) AddFunc5(spec string, cmd func(...interface{}), n int32, tag string, task string) error {
-	return c.AddJob(spec, FuncJob(cmd), n, tag, task)
+	return c.AddJobOpts(spec, FuncJob(cmd), WithEntryArgLen(n), WithEntryTag(tag), WithEntryTask(task))
 }
 
+// Deprecated: use AddJobOpts with WithEntryArgLen/WithEntryTag/WithEntryTask/WithEntryParams instead.
 func (c *Cron) AddFunc6(spec string, cmd func(...interface{}), n int32, tag string, task string, params string) error {
-	return c.AddJob(spec, FuncJob(cmd), n, tag, task, params)
+	return c.AddJobOpts(spec, FuncJob(cmd), WithEntryArgLen(n), WithEntryTag(tag), WithEntryTask(task), WithEntryParams(params))
 }
 
 // AddJob adds a Job to the Cron to be run on the given schedule.
 func (c *Cron) AddJob(spec string, cmd Job, extArgs ...interface{}) error {
-	schedule, err := Parse(spec)
+	schedule, err := c.parse(spec)
 	if err != nil {
 		return err
 	}
@@ -143,11 +223,33 @@ func (c *Cron) AddJob(spec string, cmd Job, extArgs ...interface{}) error {
 	return nil
 }
 
+// AddJobOpts parses spec and adds cmd to the Cron, configured by the given
+// EntryOptions. It supersedes the AddFunc3/AddFunc4/AddFunc5/AddFunc6
+// overload set with a single ergonomic entry point.
+func (c *Cron) AddJobOpts(spec string, cmd Job, opts ...EntryOption) error {
+	schedule, err := c.parse(spec)
+	if err != nil {
+		return err
+	}
+	c.ScheduleOpts(schedule, cmd, opts...)
+	return nil
+}
+
+// parse interprets spec using the Cron's configured parser, falling back to
+// the package-level Parse if none was set via WithParser.
+func (c *Cron) parse(spec string) (Schedule, error) {
+	if c.parser != nil {
+		return c.parser.Parse(spec)
+	}
+	return Parse(spec)
+}
+
 // Schedule adds a Job to the Cron to be run on the given schedule.
 func (c *Cron) Schedule(schedule Schedule, cmd Job, extArgs ...interface{}) {
 	entry := &Entry{
-		Schedule: schedule,
-		Job:      cmd,
+		Schedule:   schedule,
+		Job:        cmd,
+		WrappedJob: c.WrapJob(cmd),
 	}
 	extArgsInner := extArgs[0].([]interface{})
 	switch len(extArgsInner) {
@@ -164,25 +266,123 @@ func (c *Cron) Schedule(schedule Schedule, cmd Job, extArgs ...interface{}) {
 		entry.ArgLen = extArgsInner[0].(int32)
 		entry.Tag = extArgsInner[1].(string)
 		entry.Task = extArgsInner[2].(string)
-		entry.Params = extArgsInner[3].(string)	
+		entry.Params = extArgsInner[3].(string)
 	}
 
-	if !c.running {
-		entry.Id = c.nextId()
-		c.entries = append(c.entries, entry)
+	c.addEntry(entry)
+}
+
+// ScheduleOpts adds cmd to the Cron on the given schedule, configured by
+// the given EntryOptions.
+func (c *Cron) ScheduleOpts(schedule Schedule, cmd Job, opts ...EntryOption) {
+	entry := &Entry{
+		Schedule: schedule,
+		Job:      cmd,
+	}
+	for _, opt := range opts {
+		opt(entry)
+	}
+	if entry.WrappedJob == nil {
+		entry.WrappedJob = c.WrapJob(cmd)
+	}
+
+	c.addEntry(entry)
+}
+
+// addEntry computes entry's first activation time and pushes it onto the
+// entries heap, whether or not the Cron is currently running. This is what
+// replaces the old running/not-running branch in Schedule/ScheduleOpts:
+// since entries is always guarded by mu, there's no unsynchronized slice to
+// corrupt and no need to route through the run loop just to append.
+func (c *Cron) addEntry(entry *Entry) {
+	entry.Next = entry.Schedule.Next(c.now())
+
+	c.mu.Lock()
+	entry.Id = c.nextIdLocked()
+	heap.Push(&c.entries, entry)
+	c.byId[entry.Id] = entry
+	c.mu.Unlock()
+
+	c.logger.Info("added entry", "entry", entry.Id, "next", entry.Next)
+	c.wake()
+}
+
+// wake pokes the run loop to recompute its sleep after entries changed. It
+// never blocks: if the loop isn't currently waiting on wakeup (including
+// because it hasn't been started yet), the poke waits in wakeup's one-slot
+// buffer, and if a poke is already pending there this one is dropped, since
+// the loop recomputes from the current heap state every time it wakes
+// anyway.
+func (c *Cron) wake() {
+	select {
+	case c.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// WrapJob decorates cmd with the Cron's job chain (panic recovery by
+// default), so callers that bypass AddJob/Schedule can still dispatch jobs
+// safely.
+func (c *Cron) WrapJob(cmd Job) Job {
+	return c.chain.Then(cmd)
+}
+
+// startJob dispatches the entry's wrapped job with its configured args and
+// logs its start and completion (with duration).
+func (c *Cron) startJob(e *Entry) {
+	c.logger.Info("starting entry", "entry", e.Id)
+	start := time.Now()
+	switch e.ArgLen {
+	case 0:
+		e.WrappedJob.Run()
+	case 1:
+		e.WrappedJob.Run(e.Id)
+	case 2:
+		e.WrappedJob.Run(e.Id, e.Tag)
+	case 3:
+		e.WrappedJob.Run(e.Id, e.Tag, e.Task)
+	case 4:
+		e.WrappedJob.Run(e.Id, e.Tag, e.Task, e.Params)
+	}
+	c.logger.Info("entry completed", "entry", e.Id, "duration", time.Since(start))
+}
+
+// dispatch runs e's job in its own goroutine, tracked by jobWg so Stop can
+// wait for it to finish. If e is LeaderOnly and the Cron has a Coordinator,
+// the Acquire call itself also happens inside that goroutine, so a slow or
+// wedged Coordinator only delays e's own dispatch and never blocks the run
+// loop from moving on to the rest of the current due-entries batch.
+//
+// tick is this activation's own timestamp, e.g. the Prev dueEntries just
+// set for it. It's passed in rather than read off e inside the goroutine
+// because by the time that goroutine runs, the run loop may already have
+// mutated e.Next/e.Prev again for a later activation.
+func (c *Cron) dispatch(e *Entry, tick time.Time) {
+	if c.coordinator != nil && e.LeaderOnly {
+		c.jobWg.Add(1)
+		go func() {
+			defer c.jobWg.Done()
+			ok, release := c.acquireTick(e, tick)
+			if !ok {
+				return
+			}
+			defer release()
+			c.startJob(e)
+		}()
 		return
 	}
 
-	c.add <- entry
+	c.jobWg.Add(1)
+	go func() {
+		defer c.jobWg.Done()
+		c.startJob(e)
+	}()
 }
 
 // Entries returns a snapshot of the cron entries.
 func (c *Cron) Entries() []*Entry {
-	if c.running {
-		c.snapshot <- nil
-		x := <-c.snapshot
-		return x
-	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.entrySnapshot()
 }
 
@@ -191,217 +391,306 @@ func (c *Cron) Location() *time.Location {
 	return c.location
 }
 
-// Start the cron scheduler in its own go-routine, or no-op if already started.
+// Start starts the cron scheduler in its own goroutine, or no-ops if
+// already running.
 func (c *Cron) Start() {
-	if c.running {
+	ctx, ok := c.startLocked(context.Background())
+	if !ok {
 		return
 	}
-	c.running = true
-	go c.run()
+	c.recomputeStaleEntries()
+	c.loadFromStore()
+	go c.run(ctx)
 }
 
-// Run the cron scheduler, or no-op if already running.
-func (c *Cron) Run() {
-	if c.running {
+// Run runs the cron scheduler on the calling goroutine until ctx is
+// canceled, or no-ops if already running. It returns once the run loop has
+// exited; in-flight jobs may still be finishing, and Stop's returned
+// context can be awaited for that.
+func (c *Cron) Run(ctx context.Context) {
+	ctx, ok := c.startLocked(ctx)
+	if !ok {
 		return
 	}
+	c.recomputeStaleEntries()
+	c.loadFromStore()
+	c.run(ctx)
+}
+
+// startLocked marks the Cron running under a child of parent, recording the
+// cancel func and done channel that Stop uses to shut it down. ok is false
+// if the Cron was already running, in which case ctx is the zero Context
+// and must not be used.
+func (c *Cron) startLocked(parent context.Context) (ctx context.Context, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.running {
+		return nil, false
+	}
+	ctx, c.cancel = context.WithCancel(parent)
+	c.done = make(chan struct{})
 	c.running = true
-	c.run()
+	return ctx, true
 }
 
-func (c *Cron) runWithRecovery(j Job, args ...interface{}) {
-	defer func() {
-		if r := recover(); r != nil {
-			const size = 64 << 10
-			buf := make([]byte, size)
-			buf = buf[:runtime.Stack(buf, false)]
-			c.logf("cron: panic running job: %v\n%s", r, buf)
+// run is the scheduler's event loop. It sleeps until the earliest entry in
+// the heap is due, a wakeup signals that entries changed, or ctx is
+// canceled. All heap access is done under mu, held while updating the heap
+// (and, with a Store configured, while persisting each rescheduled entry's
+// run times); jobs are dispatched after releasing it so a slow Coordinator
+// or job launch never blocks AddJob/Remove callers.
+func (c *Cron) run(ctx context.Context) {
+	defer close(c.done)
+	for {
+		timer := time.NewTimer(c.sleepDuration())
+
+		select {
+		case <-timer.C:
+			for _, e := range c.dueEntries() {
+				c.dispatch(e, e.Prev)
+			}
+
+		case <-c.wakeup:
+			timer.Stop()
+
+		case <-ctx.Done():
+			timer.Stop()
+			return
 		}
-	}()
-	j.Run(args...)
+	}
 }
 
-// Run the scheduler. this is private just due to the need to synchronize
-// access to the 'running' state variable.
-func (c *Cron) run() {
-	// Figure out the next activation times for each entry.
-	now := c.now()
-	for _, entry := range c.entries {
-		entry.Next = entry.Schedule.Next(now)
+// sleepDuration returns how long the run loop should sleep before its next
+// entry is due.
+func (c *Cron) sleepDuration() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries.Len() == 0 || c.entries[0].Next.IsZero() {
+		// If there are no entries yet, just sleep - it still handles new
+		// entries and stop requests via wakeup/ctx.Done.
+		return 100000 * time.Hour
 	}
+	return c.entries[0].Next.Sub(c.now())
+}
 
-	for {
-		// Determine the next entry to run.
-		sort.Sort(byTime(c.entries))
-
-		var timer *time.Timer
-		if len(c.entries) == 0 || c.entries[0].Next.IsZero() {
-			// If there are no entries yet, just sleep - it still handles new entries
-			// and stop requests.
-			timer = time.NewTimer(100000 * time.Hour)
-		} else {
-			timer = time.NewTimer(c.entries[0].Next.Sub(now))
+// dueEntries pops every entry whose Next has arrived, reschedules it, and
+// returns the batch to dispatch. It's the only place the run loop mutates
+// the heap.
+func (c *Cron) dueEntries() []*Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	var due []*Entry
+	for c.entries.Len() > 0 {
+		e := c.entries[0]
+		if e.Next.After(now) || e.Next.IsZero() {
+			break
 		}
+		due = append(due, e)
 
-		for {
-			select {
-			case now = <-timer.C:
-				now = now.In(c.location)
-				// Run every entry whose next time was less than now
-				for _, e := range c.entries {
-					if e.Next.After(now) || e.Next.IsZero() {
-						break
-					}
-					switch e.ArgLen {
-					case 0:
-						go c.runWithRecovery(e.Job)
-					case 1:
-						go c.runWithRecovery(e.Job, e.Id)
-					case 2:
-						go c.runWithRecovery(e.Job, e.Id, e.Tag)
-					case 3:
-						go c.runWithRecovery(e.Job, e.Id, e.Tag, e.Task)
-					case 4:
-						go c.runWithRecovery(e.Job, e.Id, e.Tag, e.Task, e.Params)
-					}
-					
-					e.Prev = e.Next
-					e.Next = e.Schedule.Next(now)
-				}
-
-			case newEntry := <-c.add:
-				timer.Stop()
-				now = c.now()
-				newEntry.Next = newEntry.Schedule.Next(now)
-				newEntry.Id = c.nextId()
-				c.entries = append(c.entries, newEntry)
-
-			case <-c.snapshot:
-				c.snapshot <- c.entrySnapshot()
-				continue
-
-			case targetId := <-c.remove:
-				timer.Stop()
-				if len(c.entries) <= 0 {
-					continue
-				}
-
-				if targetId >= 0 {
-					newEntrys := []*Entry{}
-					for _,v := range c.entries {
-						if targetId != v.Id {
-							newEntrys = append(newEntrys, v)
-						}
-					}
-					c.entries = newEntrys
-				} else if -1 == targetId {
-					c.entries = []*Entry{}
-				} else if -2 == targetId {
-					c.entries = c.entries[1:]
-				} else if -3 == targetId {
-					c.entries = c.entries[:len(c.entries)-1]
-				}
-
-			case <-c.stop:
-				timer.Stop()
-				return
-			}
+		e.Prev = e.Next
+		e.Next = e.Schedule.Next(now)
+		heap.Fix(&c.entries, 0)
 
-			break
+		c.logger.Info("next scheduled run", "entry", e.Id, "next", e.Next)
+		if c.store != nil {
+			if err := c.store.UpdateRun(e.Id, e.Prev, e.Next); err != nil {
+				c.logger.Error(err, "failed to persist run", "entry", e.Id)
+			}
 		}
 	}
+	return due
 }
 
-// Logs an error to stderr or to the configured error log
-func (c *Cron) logf(format string, args ...interface{}) {
-	if c.ErrorLog != nil {
-		c.ErrorLog.Printf(format, args...)
-	} else {
-		log.Printf(format, args...)
+// recomputeStaleEntries re-anchors any entry whose Next already elapsed
+// before Start/Run was called, so an entry added long before the scheduler
+// actually started doesn't fire immediately just to "catch up" the single
+// tick it missed while idle. Entries reloaded from a Store go through
+// loadFromStore/runMissed instead, which apply the configured
+// MissedRunPolicy deliberately; this only covers entries already on the
+// heap when Start/Run is called, such as those added directly via
+// AddJob/Schedule beforehand or left over from before a Stop.
+func (c *Cron) recomputeStaleEntries() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	changed := false
+	for _, e := range c.entries {
+		if e.Next.IsZero() || e.Next.After(now) {
+			continue
+		}
+		e.Next = e.Schedule.Next(now)
+		changed = true
+	}
+	if changed {
+		heap.Init(&c.entries)
 	}
 }
 
-// Stop stops the cron scheduler if it is running; otherwise it does nothing.
-func (c *Cron) Stop() {
+// Stop stops the cron scheduler if it is running, otherwise it is a no-op.
+// It returns a context that is canceled once the run loop has exited and
+// every job it dispatched has completed, so callers can wait for a
+// graceful drain instead of racing in-flight jobs against process exit.
+func (c *Cron) Stop() context.Context {
+	c.mu.Lock()
 	if !c.running {
-		return
+		c.mu.Unlock()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx
 	}
-	c.stop <- struct{}{}
+	cancel := c.cancel
+	done := c.done
 	c.running = false
+	c.mu.Unlock()
+
+	cancel()
+
+	ctx, drained := context.WithCancel(context.Background())
+	go func() {
+		<-done
+		c.jobWg.Wait()
+		drained()
+	}()
+	return ctx
 }
 
-// remove all jobs
+// RemoveAll removes every entry.
 func (c *Cron) RemoveAll() {
-	if !c.running {
-		c.entries = []*Entry{}
-		return
-	}
+	c.mu.Lock()
+	c.entries = nil
+	c.byId = make(map[EntryID]*Entry)
+	c.mu.Unlock()
 
-	c.remove <- -1
+	c.logger.Info("removed all entries")
+	c.wake()
 }
 
-// remove spec id
-func (c *Cron) Remove(id int32) {
-	if !c.running {
-		if len(c.entries) <= 0 {
-			return
-		}
+// Remove removes the entry with the given id, if any.
+func (c *Cron) Remove(id EntryID) {
+	c.mu.Lock()
+	e, ok := c.byId[id]
+	if ok {
+		heap.Remove(&c.entries, e.heapIndex)
+		delete(c.byId, id)
+	}
+	c.mu.Unlock()
 
-		newEntrys := []*Entry{}
-		if id >= 0 {
-			for _,v := range c.entries {
-				if id != v.Id {
-					newEntrys = append(newEntrys, v)
-				}
-			}
-		}
-		c.entries = newEntrys
+	if !ok {
 		return
 	}
-
-	c.remove <- id
+	c.logger.Info("removed entry", "entry", id)
+	if c.store != nil {
+		if err := c.store.Delete(id); err != nil {
+			c.logger.Error(err, "failed to delete persisted entry", "entry", id)
+		}
+	}
+	c.wake()
 }
 
-// remove top
+// RemoveFirst removes the entry that is due to run soonest, if any.
 func (c *Cron) RemoveFirst() {
-	if !c.running {
-		if len(c.entries) <= 0 {
-			return
-		}
+	c.mu.Lock()
+	var id EntryID
+	removed := c.entries.Len() > 0
+	if removed {
+		id = c.entries[0].Id
+		heap.Remove(&c.entries, 0)
+		delete(c.byId, id)
+	}
+	c.mu.Unlock()
 
-		c.entries = c.entries[1:]
+	if !removed {
 		return
 	}
-
-	c.remove <- -2
+	c.logger.Info("removed first entry", "entry", id)
+	if c.store != nil {
+		if err := c.store.Delete(id); err != nil {
+			c.logger.Error(err, "failed to delete persisted entry", "entry", id)
+		}
+	}
+	c.wake()
 }
 
-// remove top
+// RemoveLast removes the most recently added entry, if any.
 func (c *Cron) RemoveLast() {
-	if !c.running {
-		if len(c.entries) <= 0 {
-			return
+	c.mu.Lock()
+	var last *Entry
+	for _, e := range c.entries {
+		if last == nil || e.Id > last.Id {
+			last = e
 		}
+	}
+	removed := last != nil
+	if removed {
+		heap.Remove(&c.entries, last.heapIndex)
+		delete(c.byId, last.Id)
+	}
+	c.mu.Unlock()
 
-		c.entries = c.entries[:len(c.entries)-1]
+	if !removed {
 		return
 	}
+	c.logger.Info("removed last entry", "entry", last.Id)
+	if c.store != nil {
+		if err := c.store.Delete(last.Id); err != nil {
+			c.logger.Error(err, "failed to delete persisted entry", "entry", last.Id)
+		}
+	}
+	c.wake()
+}
+
+// Entry returns a copy of the entry with the given id, or the zero Entry if
+// no such entry exists.
+func (c *Cron) Entry(id EntryID) Entry {
+	for _, entry := range c.Entries() {
+		if entry.Id == id {
+			return *entry
+		}
+	}
+	return Entry{}
+}
 
-	c.remove <- -3
+// NextScheduledTime returns the next time the entry with the given id will
+// run, or the zero time if the entry doesn't exist or its schedule is
+// unsatisfiable.
+func (c *Cron) NextScheduledTime(id EntryID) time.Time {
+	return c.Entry(id).Next
+}
+
+// NextScheduledTimeForSpec parses spec with the Cron's configured parser and
+// returns its next activation time after from, without registering an
+// entry. This is useful for UIs that want to preview "next run" for a spec
+// the user is still editing.
+func (c *Cron) NextScheduledTimeForSpec(spec string, from time.Time) (time.Time, error) {
+	schedule, err := c.parse(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(from), nil
 }
 
-// entrySnapshot returns a copy of the current cron entry list.
+// entrySnapshot returns a copy of the current cron entry list. Callers must
+// hold mu.
 func (c *Cron) entrySnapshot() []*Entry {
-	entries := []*Entry{}
+	entries := make([]*Entry, 0, len(c.entries))
 	for _, e := range c.entries {
 		entries = append(entries, &Entry{
-			Schedule: e.Schedule,
-			Next:     e.Next,
-			Prev:     e.Prev,
-			Job:      e.Job,
-			Id:       e.Id,
-			ArgLen:   e.ArgLen,
-			Tag:      e.Tag,
+			Schedule:   e.Schedule,
+			Next:       e.Next,
+			Prev:       e.Prev,
+			Job:        e.Job,
+			WrappedJob: e.WrappedJob,
+			Id:         e.Id,
+			ArgLen:     e.ArgLen,
+			Tag:        e.Tag,
+			Task:       e.Task,
+			Params:     e.Params,
+			LeaderOnly: e.LeaderOnly,
+			LeaderKey:  e.LeaderKey,
+			heapIndex:  -1,
 		})
 	}
 	return entries
@@ -412,7 +701,8 @@ func (c *Cron) now() time.Time {
 	return time.Now().In(c.location)
 }
 
-func (c *Cron) nextId() int32 {
+// nextIdLocked returns the next unused EntryID. Callers must hold mu.
+func (c *Cron) nextIdLocked() EntryID {
 	oid := c.id
 	c.id = c.id + 1
 	return oid
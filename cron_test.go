@@ -0,0 +1,110 @@
+package cron
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestRunLoopDispatchesDueEntry exercises the mutex-guarded heap end to
+// end: AddFunc while stopped, Start, wait for a due tick to dispatch, then
+// Stop and confirm its drained context reflects the in-flight job having
+// finished.
+func TestRunLoopDispatchesDueEntry(t *testing.T) {
+	var ran int32
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	c := New(WithSeconds())
+	if err := c.AddJobOpts("* * * * * *", FuncJob(func(...interface{}) {
+		if atomic.AddInt32(&ran, 1) == 1 {
+			wg.Done()
+		}
+	})); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+
+	c.Start()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("job never dispatched by run loop within 3s")
+	}
+
+	ctx := c.Stop()
+	select {
+	case <-ctx.Done():
+	case <-time.After(3 * time.Second):
+		t.Fatal("Stop's context never drained")
+	}
+}
+
+// TestStaleEntryDoesNotFireImmediatelyOnStart covers an entry added well
+// before Start is called: its Next, computed relative to add time, is
+// already in the past by the time the run loop starts. Start must
+// re-anchor it to the actual start time instead of letting the run loop
+// treat it as overdue and fire it immediately.
+func TestStaleEntryDoesNotFireImmediatelyOnStart(t *testing.T) {
+	var ran int32
+	c := New()
+	if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {
+		atomic.AddInt32(&ran, 1)
+	})); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+
+	// Simulate a long gap between AddJobOpts and Start by backdating the
+	// live entry's Next past due, the way it would be found if Start had
+	// genuinely been called an hour late.
+	id := c.Entries()[0].Id
+	c.mu.Lock()
+	c.byId[id].Next = time.Now().Add(-time.Minute)
+	heap.Fix(&c.entries, c.byId[id].heapIndex)
+	c.mu.Unlock()
+
+	c.Start()
+	defer c.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	if atomic.LoadInt32(&ran) != 0 {
+		t.Fatalf("job ran %d times immediately on Start, want 0 (stale Next should be re-anchored, not treated as due)", ran)
+	}
+	if got := c.Entry(id).Next; !got.After(time.Now().Add(-time.Second)) {
+		t.Fatalf("Entry.Next = %v after Start, want recomputed relative to start time", got)
+	}
+}
+
+// TestConcurrentAddAndRemoveDuringRun adds and removes entries from other
+// goroutines while the run loop is active, guarding against the races the
+// mutex+heap rework was meant to close. Run under -race.
+func TestConcurrentAddAndRemoveDuringRun(t *testing.T) {
+	c := New()
+	c.Start()
+	defer c.Stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {})); err != nil {
+				t.Errorf("AddJobOpts: %v", err)
+				return
+			}
+			c.RemoveFirst()
+		}()
+	}
+	wg.Wait()
+
+	if got := len(c.Entries()); got != 0 {
+		t.Fatalf("Entries() after concurrent add/remove = %d, want 0", got)
+	}
+}
@@ -0,0 +1,97 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+// TestEntryLooksUpById covers Cron.Entry(id) returning the matching entry,
+// and the zero Entry for an id that doesn't exist.
+func TestEntryLooksUpById(t *testing.T) {
+	c := New()
+	if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {}), WithEntryTag("first")); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+	id := c.Entries()[0].Id
+
+	got := c.Entry(id)
+	if got.Id != id || got.Tag != "first" {
+		t.Fatalf("Entry(%v) = %+v, want Id=%v Tag=first", id, got, id)
+	}
+
+	if got := c.Entry(id + 1); got != (Entry{}) {
+		t.Fatalf("Entry(unknown) = %+v, want the zero Entry", got)
+	}
+}
+
+// TestNextScheduledTimeMatchesEntry covers NextScheduledTime as a
+// convenience over Entry(id).Next, including the zero time for an unknown
+// id.
+func TestNextScheduledTimeMatchesEntry(t *testing.T) {
+	c := New()
+	if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {})); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+	id := c.Entries()[0].Id
+
+	want := c.Entry(id).Next
+	if want.IsZero() {
+		t.Fatal("Entry(id).Next is zero, want a real activation time")
+	}
+	if got := c.NextScheduledTime(id); !got.Equal(want) {
+		t.Fatalf("NextScheduledTime(%v) = %v, want %v", id, got, want)
+	}
+
+	if got := c.NextScheduledTime(id + 1); !got.IsZero() {
+		t.Fatalf("NextScheduledTime(unknown) = %v, want the zero time", got)
+	}
+}
+
+// TestNextScheduledTimeForSpecDoesNotRegisterEntry covers previewing a
+// spec's next activation without adding it as an entry.
+func TestNextScheduledTimeForSpecDoesNotRegisterEntry(t *testing.T) {
+	c := New()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := c.NextScheduledTimeForSpec("@every 1h", from)
+	if err != nil {
+		t.Fatalf("NextScheduledTimeForSpec: %v", err)
+	}
+	if want := from.Add(time.Hour); !got.Equal(want) {
+		t.Fatalf("NextScheduledTimeForSpec = %v, want %v", got, want)
+	}
+	if n := len(c.Entries()); n != 0 {
+		t.Fatalf("Entries() = %d, want 0 (NextScheduledTimeForSpec must not register an entry)", n)
+	}
+
+	if _, err := c.NextScheduledTimeForSpec("not a spec", from); err == nil {
+		t.Fatal("NextScheduledTimeForSpec with an invalid spec succeeded, want an error")
+	}
+}
+
+// TestEntryIDsAreUniqueAndIncreasing covers successive AddJob calls
+// getting distinct, increasing EntryIDs, which RemoveLast and the
+// AddStoredJob/loadFromStore reconciliation both rely on.
+func TestEntryIDsAreUniqueAndIncreasing(t *testing.T) {
+	c := New()
+	var ids []EntryID
+	for i := 0; i < 3; i++ {
+		if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {})); err != nil {
+			t.Fatalf("AddJobOpts: %v", err)
+		}
+	}
+	for _, e := range c.Entries() {
+		ids = append(ids, e.Id)
+	}
+
+	seen := make(map[EntryID]bool)
+	for i, id := range ids {
+		if seen[id] {
+			t.Fatalf("duplicate EntryID %v", id)
+		}
+		seen[id] = true
+		if i > 0 && id <= ids[i-1] {
+			t.Fatalf("EntryIDs not increasing: %v", ids)
+		}
+	}
+}
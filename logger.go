@@ -0,0 +1,61 @@
+package cron
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+// DefaultLogger is used by a Cron that hasn't been given one explicitly.
+var DefaultLogger Logger = PrintfLogger(log.New(os.Stdout, "cron: ", log.LstdFlags))
+
+// DiscardLogger can be used to discard all log messages.
+var DiscardLogger Logger = PrintfLogger(log.New(ioutil.Discard, "", 0))
+
+// Logger is the interface used throughout this package for logging, so that
+// any structured-logging backend (zap, zerolog, slog, ...) can be plugged in.
+// It is a subset of the github.com/go-logr/logr interface.
+type Logger interface {
+	// Info logs routine messages about cron's operation.
+	Info(msg string, keysAndValues ...interface{})
+	// Error logs an error condition.
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+// PrintfLogger wraps a Printf-based logger (such as the standard library
+// "log" package) as a Logger, so existing callers that configured a
+// *log.Logger keep working unchanged.
+func PrintfLogger(l *log.Logger) Logger {
+	return printfLogger{l}
+}
+
+type printfLogger struct {
+	logger *log.Logger
+}
+
+func (pl printfLogger) Info(msg string, keysAndValues ...interface{}) {
+	pl.logger.Printf(formatString(len(keysAndValues)), append([]interface{}{msg}, keysAndValues...)...)
+}
+
+func (pl printfLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	keysAndValues = append(keysAndValues, "error", err)
+	pl.logger.Printf(formatString(len(keysAndValues)), append([]interface{}{msg}, keysAndValues...)...)
+}
+
+// formatString builds a Printf format string for a msg followed by n
+// alternating key/value pairs, e.g. "%v, %v=%v, %v=%v".
+func formatString(numKeysAndValues int) string {
+	var sb strings.Builder
+	sb.WriteString("%v")
+	if numKeysAndValues > 0 {
+		sb.WriteString(", ")
+	}
+	for i := 0; i < numKeysAndValues/2; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("%v=%v")
+	}
+	return sb.String()
+}
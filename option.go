@@ -0,0 +1,95 @@
+package cron
+
+import "time"
+
+// Option represents a modification to the default behavior of a Cron.
+type Option func(*Cron)
+
+// WithLocation overrides the timezone of the new Cron instance.
+func WithLocation(loc *time.Location) Option {
+	return func(c *Cron) {
+		c.location = loc
+	}
+}
+
+// WithLogger sets the logger the Cron reports to. This includes the
+// default job chain's panic recovery, unless WithChain overrides it with
+// one of the caller's own.
+func WithLogger(logger Logger) Option {
+	return func(c *Cron) {
+		c.logger = logger
+	}
+}
+
+// ScheduleParser is the interface a pluggable cron-spec parser must
+// implement to be used via WithParser.
+type ScheduleParser interface {
+	Parse(spec string) (Schedule, error)
+}
+
+// WithParser overrides the parser used for interpreting job schedule specs.
+func WithParser(p ScheduleParser) Option {
+	return func(c *Cron) {
+		c.parser = p
+	}
+}
+
+// WithChain sets the JobWrappers to apply to every Job added to this Cron,
+// replacing the default panic-recovery-only chain.
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(c *Cron) {
+		c.chain = NewChain(wrappers...)
+		c.hasChain = true
+	}
+}
+
+// WithSeconds overrides the parser used for interpreting job schedule specs
+// to include a seconds field as the first one, e.g. "* * * * * *" instead of
+// the usual five-field "* * * * *".
+func WithSeconds() Option {
+	return WithParser(NewParser(Second | Minute | Hour | Dom | Month | Dow | Descriptor))
+}
+
+// EntryOption configures a single Entry at AddJob/Schedule time, via
+// AddJobOpts/ScheduleOpts.
+type EntryOption func(*Entry)
+
+// WithEntryChain overrides the job chain applied to this entry only,
+// instead of the Cron's default chain.
+func WithEntryChain(wrappers ...JobWrapper) EntryOption {
+	return func(e *Entry) {
+		e.WrappedJob = NewChain(wrappers...).Then(e.Job)
+	}
+}
+
+// WithEntryArgLen sets how many of Id, Tag, Task, and Params are passed to
+// the job when it runs (0 through 4).
+func WithEntryArgLen(n int32) EntryOption {
+	return func(e *Entry) {
+		e.ArgLen = n
+	}
+}
+
+// WithEntryTag sets the entry's Tag, delivered as the job's second argument
+// when ArgLen is at least 2.
+func WithEntryTag(tag string) EntryOption {
+	return func(e *Entry) {
+		e.Tag = tag
+	}
+}
+
+// WithEntryTask sets the entry's Task, delivered as the job's third
+// argument when ArgLen is at least 3.
+func WithEntryTask(task string) EntryOption {
+	return func(e *Entry) {
+		e.Task = task
+	}
+}
+
+// WithEntryParams sets the entry's Params, delivered as the job's fourth
+// argument when ArgLen is 4.
+func WithEntryParams(params string) EntryOption {
+	return func(e *Entry) {
+		e.Params = params
+	}
+}
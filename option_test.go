@@ -0,0 +1,126 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+// TestWithLocationSetsLocation covers New(WithLocation(...)) actually
+// configuring the Cron's Location, per its doc comment.
+func TestWithLocationSetsLocation(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	c := New(WithLocation(loc))
+
+	if got := c.Location(); got != loc {
+		t.Fatalf("Location() = %v, want %v", got, loc)
+	}
+}
+
+// TestWithSecondsAcceptsSixFieldSpec covers WithSeconds switching the
+// Cron's parser to one that requires a leading seconds field, rejecting
+// specs that omit it.
+func TestWithSecondsAcceptsSixFieldSpec(t *testing.T) {
+	c := New(WithSeconds())
+
+	if err := c.AddFunc("* * * * * *", func(...interface{}) {}); err != nil {
+		t.Fatalf("AddFunc with six fields: %v", err)
+	}
+	if err := c.AddFunc("* * * * *", func(...interface{}) {}); err == nil {
+		t.Fatal("AddFunc with five fields under WithSeconds succeeded, want an error")
+	}
+}
+
+// TestWithParserOverridesSpecParsing covers WithParser routing AddJob's
+// spec through the given ScheduleParser instead of the package default.
+func TestWithParserOverridesSpecParsing(t *testing.T) {
+	want := ConstantDelaySchedule{Delay: time.Minute}
+	c := New(WithParser(stubParser{schedule: want}))
+
+	if err := c.AddFunc("this is not a real spec", func(...interface{}) {}); err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+
+	entries := c.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Entries() = %d, want 1", len(entries))
+	}
+	if got, ok := entries[0].Schedule.(ConstantDelaySchedule); !ok || got != want {
+		t.Fatalf("Schedule = %#v, want %#v", entries[0].Schedule, want)
+	}
+}
+
+// TestWithLoggerDoesNotOverrideChain covers WithLogger only setting the
+// logger, regardless of option order: WithChain's chain must survive a
+// later WithLogger call instead of being silently replaced by a fresh
+// Recover-only one.
+func TestWithLoggerDoesNotOverrideChain(t *testing.T) {
+	var wrapped bool
+	wrapper := func(j Job) Job {
+		return FuncJob(func(args ...interface{}) {
+			wrapped = true
+			j.Run(args...)
+		})
+	}
+
+	c := New(WithChain(wrapper), WithLogger(DefaultLogger))
+	if err := c.AddFunc("@every 1h", func(...interface{}) {}); err != nil {
+		t.Fatalf("AddFunc: %v", err)
+	}
+	c.Entries()[0].WrappedJob.Run()
+
+	if !wrapped {
+		t.Fatal("WithChain's wrapper never ran; a later WithLogger call overwrote the chain")
+	}
+}
+
+type stubParser struct {
+	schedule Schedule
+}
+
+func (p stubParser) Parse(spec string) (Schedule, error) {
+	return p.schedule, nil
+}
+
+// TestEntryOptionsOverrideArgLenTagTaskParams covers AddJobOpts applying
+// WithEntryArgLen/WithEntryTag/WithEntryTask/WithEntryParams to the added
+// entry.
+func TestEntryOptionsOverrideArgLenTagTaskParams(t *testing.T) {
+	c := New()
+	if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {}),
+		WithEntryArgLen(4), WithEntryTag("tag"), WithEntryTask("task"), WithEntryParams("params"),
+	); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+
+	entries := c.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Entries() = %d, want 1", len(entries))
+	}
+	e := entries[0]
+	if e.ArgLen != 4 || e.Tag != "tag" || e.Task != "task" || e.Params != "params" {
+		t.Fatalf("entry = %+v, want ArgLen=4 Tag=tag Task=task Params=params", e)
+	}
+}
+
+// TestWithEntryChainOverridesCronChain covers WithEntryChain replacing the
+// Cron's default chain for a single entry, without affecting others.
+func TestWithEntryChainOverridesCronChain(t *testing.T) {
+	var wrapped bool
+	c := New()
+
+	if err := c.AddJobOpts("@every 1h", FuncJob(func(...interface{}) {}), WithEntryChain(func(j Job) Job {
+		return FuncJob(func(args ...interface{}) {
+			wrapped = true
+			j.Run(args...)
+		})
+	})); err != nil {
+		t.Fatalf("AddJobOpts: %v", err)
+	}
+
+	entries := c.Entries()
+	entries[0].WrappedJob.Run()
+
+	if !wrapped {
+		t.Fatal("WithEntryChain's wrapper never ran; WrappedJob wasn't built from the entry chain")
+	}
+}
@@ -0,0 +1,402 @@
+package cron
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseOption is a bitmask naming the fields and syntax a Parser accepts.
+type ParseOption int
+
+const (
+	Second     ParseOption = 1 << iota // Second field, default 0
+	Minute                             // Minute field, default 0
+	Hour                               // Hour field, default 0
+	Dom                                // Day of month field, default *
+	Month                              // Month field, default *
+	Dow                                // Day of week field, default *
+	Descriptor                         // Allow descriptors such as @monthly, @every 1h
+)
+
+// defaultParser is the five-field parser (minute hour dom month dow) with
+// descriptor support, used by the package-level Parse.
+var defaultParser = NewParser(Minute | Hour | Dom | Month | Dow | Descriptor)
+
+// Parse parses spec with the package's default five-field parser. It's
+// the fallback Cron.parse uses when no WithParser option was given.
+func Parse(spec string) (Schedule, error) {
+	return defaultParser.Parse(spec)
+}
+
+// Parser parses cron specs into Schedules, accepting exactly the fields
+// named by its options.
+type Parser struct {
+	options ParseOption
+}
+
+// NewParser returns a Parser accepting the fields and descriptors named by
+// options (bits ORed together, e.g. Second|Minute|Hour|Dom|Month|Dow).
+func NewParser(options ParseOption) Parser {
+	return Parser{options}
+}
+
+// Parse returns a new Schedule based on spec, or an error if spec is
+// malformed or doesn't match the fields this Parser was configured with.
+// It accepts crontab specs and, if configured with Descriptor, descriptors
+// such as "@yearly" and "@every <duration>".
+func (p Parser) Parse(spec string) (sched Schedule, err error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("cron: empty spec string")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			sched, err = nil, fmt.Errorf("cron: %v", r)
+		}
+	}()
+
+	if spec[0] == '@' && p.options&Descriptor > 0 {
+		return parseDescriptor(spec)
+	}
+
+	fields := strings.Fields(spec)
+	fields = p.populateFields(fields)
+
+	second := getField(fields[0], seconds)
+	minute := getField(fields[1], minutes)
+	hour := getField(fields[2], hours)
+	dayOfMonth := getField(fields[3], dom)
+	month := getField(fields[4], months)
+	dayOfWeek := getField(fields[5], dow)
+
+	return &SpecSchedule{
+		Second: second,
+		Minute: minute,
+		Hour:   hour,
+		Dom:    dayOfMonth,
+		Month:  month,
+		Dow:    dayOfWeek,
+	}, nil
+}
+
+// fieldOrder is the canonical field order, paired with each field's
+// always-match default for when p's options don't request it.
+var fieldOrder = []struct {
+	opt  ParseOption
+	dflt string
+}{
+	{Second, "0"},
+	{Minute, "0"},
+	{Hour, "0"},
+	{Dom, "*"},
+	{Month, "*"},
+	{Dow, "*"},
+}
+
+// populateFields expands the whitespace-split fields of a spec out to
+// exactly 6 entries (second, minute, hour, dom, month, dow) in that order,
+// filling in any field p's options don't request with its default. It
+// panics (recovered by Parse) if fields doesn't have exactly as many
+// entries as p's options request.
+func (p Parser) populateFields(fields []string) []string {
+	want := bits.OnesCount(uint(p.options & (Second | Minute | Hour | Dom | Month | Dow)))
+	if len(fields) != want {
+		panic(fmt.Sprintf("expected %d fields, found %d: %q", want, len(fields), fields))
+	}
+
+	populated := make([]string, 0, 6)
+	i := 0
+	for _, f := range fieldOrder {
+		if p.options&f.opt > 0 {
+			populated = append(populated, fields[i])
+			i++
+			continue
+		}
+		populated = append(populated, f.dflt)
+	}
+	return populated
+}
+
+// bounds is the inclusive range of values a field accepts, plus an
+// optional case-insensitive name table (e.g. "mon" -> 1).
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	seconds = bounds{0, 59, nil}
+	minutes = bounds{0, 59, nil}
+	hours   = bounds{0, 23, nil}
+	dom     = bounds{1, 31, nil}
+	months  = bounds{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dow = bounds{0, 6, map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// starBit is set on a field's bitmask alongside its matched values to
+// record that the field was an unrestricted "*", which dayMatches needs to
+// tell apart from an explicit value that happens to cover the whole range.
+const starBit = 1 << 63
+
+// parseDescriptor returns the predefined schedule for a descriptor such as
+// "@yearly", or a ConstantDelaySchedule for "@every <duration>".
+func parseDescriptor(descriptor string) (Schedule, error) {
+	switch descriptor {
+	case "@yearly", "@annually":
+		return &SpecSchedule{
+			Second: 1 << seconds.min,
+			Minute: 1 << minutes.min,
+			Hour:   1 << hours.min,
+			Dom:    1 << dom.min,
+			Month:  1 << months.min,
+			Dow:    all(dow),
+		}, nil
+
+	case "@monthly":
+		return &SpecSchedule{
+			Second: 1 << seconds.min,
+			Minute: 1 << minutes.min,
+			Hour:   1 << hours.min,
+			Dom:    1 << dom.min,
+			Month:  all(months),
+			Dow:    all(dow),
+		}, nil
+
+	case "@weekly":
+		return &SpecSchedule{
+			Second: 1 << seconds.min,
+			Minute: 1 << minutes.min,
+			Hour:   1 << hours.min,
+			Dom:    all(dom),
+			Month:  all(months),
+			Dow:    1 << dow.min,
+		}, nil
+
+	case "@daily", "@midnight":
+		return &SpecSchedule{
+			Second: 1 << seconds.min,
+			Minute: 1 << minutes.min,
+			Hour:   1 << hours.min,
+			Dom:    all(dom),
+			Month:  all(months),
+			Dow:    all(dow),
+		}, nil
+
+	case "@hourly":
+		return &SpecSchedule{
+			Second: 1 << seconds.min,
+			Minute: 1 << minutes.min,
+			Hour:   all(hours),
+			Dom:    all(dom),
+			Month:  all(months),
+			Dow:    all(dow),
+		}, nil
+	}
+
+	const every = "@every "
+	if strings.HasPrefix(descriptor, every) {
+		duration, err := time.ParseDuration(descriptor[len(every):])
+		if err != nil {
+			return nil, fmt.Errorf("cron: failed to parse duration %s: %s", descriptor, err)
+		}
+		return ConstantDelaySchedule{Delay: duration}, nil
+	}
+
+	return nil, fmt.Errorf("cron: unrecognized descriptor: %s", descriptor)
+}
+
+// all returns the bitmask matching every value in r's range, with starBit
+// set to mark it as a wildcard match.
+func all(r bounds) uint64 {
+	return getRange(r.min, r.max, 1, r) | starBit
+}
+
+// getField parses field, a comma-separated list of range expressions
+// (e.g. "1-5/2,8,mon-fri"), into the bitmask of values it matches within r.
+func getField(field string, r bounds) uint64 {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		bits |= getRangeExpr(expr, r)
+	}
+	return bits
+}
+
+// getRangeExpr parses a single range expression such as "*", "5", "1-5" or
+// "*/2" into the bitmask of values it matches within r.
+func getRangeExpr(expr string, r bounds) uint64 {
+	rangeAndStep := strings.SplitN(expr, "/", 2)
+	lowAndHigh := strings.SplitN(rangeAndStep[0], "-", 2)
+
+	var start, end uint
+	var wildcard bool
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		start, end, wildcard = r.min, r.max, true
+	} else {
+		start = parseIntOrName(lowAndHigh[0], r.names)
+		end = start
+		if len(lowAndHigh) == 2 {
+			end = parseIntOrName(lowAndHigh[1], r.names)
+		}
+	}
+
+	step := uint(1)
+	if len(rangeAndStep) == 2 {
+		step = mustParseUint(rangeAndStep[1])
+		if step == 0 {
+			panic(fmt.Sprintf("step of range should be a positive number: %q", expr))
+		}
+		wildcard = false
+	}
+
+	if start < r.min {
+		panic(fmt.Sprintf("beginning of range (%d) below minimum (%d): %q", start, r.min, expr))
+	}
+	if end > r.max {
+		panic(fmt.Sprintf("end of range (%d) above maximum (%d): %q", end, r.max, expr))
+	}
+	if start > end {
+		panic(fmt.Sprintf("beginning of range (%d) past end of range (%d): %q", start, end, expr))
+	}
+
+	bits := getRange(start, end, step, r)
+	if wildcard {
+		bits |= starBit
+	}
+	return bits
+}
+
+// getRange returns the bitmask of every value in [min, max] that is a
+// multiple of step away from min, with bit i set for value i (the same
+// absolute positions SpecSchedule.Next tests).
+func getRange(min, max, step uint, r bounds) uint64 {
+	var bits uint64
+	for i := min; i <= max; i += step {
+		bits |= 1 << i
+	}
+	return bits
+}
+
+// parseIntOrName parses expr as an unsigned int, or, if names is non-nil,
+// looks it up case-insensitively in names (e.g. "mon" -> 1).
+func parseIntOrName(expr string, names map[string]uint) uint {
+	if names != nil {
+		if v, ok := names[strings.ToLower(expr)]; ok {
+			return v
+		}
+	}
+	return mustParseUint(expr)
+}
+
+// mustParseUint parses expr as a non-negative decimal integer, panicking
+// (recovered by Parser.Parse) if it isn't one.
+func mustParseUint(expr string) uint {
+	num, err := strconv.Atoi(expr)
+	if err != nil || num < 0 {
+		panic(fmt.Sprintf("failed to parse %q as a non-negative integer", expr))
+	}
+	return uint(num)
+}
+
+// SpecSchedule is a Schedule built from a traditional six-field crontab
+// spec (second, minute, hour, day of month, month, day of week), each
+// stored as a bitmask of matching values. Use Parser.Parse to build one.
+type SpecSchedule struct {
+	Second, Minute, Hour, Dom, Month, Dow uint64
+
+	// Location is the timezone the schedule's fields are interpreted in.
+	// If nil, Next uses the location of the time.Time passed to it.
+	Location *time.Location
+}
+
+// Next returns the next time this schedule is activated, strictly after
+// the given time, or the zero time if none is found within five years
+// (an unsatisfiable schedule, e.g. "Feb 30th").
+func (s *SpecSchedule) Next(t time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = t.Location()
+	}
+	t = t.In(loc).Add(1*time.Second - time.Duration(t.Nanosecond())*time.Nanosecond)
+
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.Month == 0 {
+		if t.Month() == time.December {
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+		} else {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		}
+		if t.Year() > yearLimit {
+			return time.Time{}
+		}
+	}
+
+	for !dayMatches(s, t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.Hour == 0 {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.Minute == 0 {
+		t = t.Add(1 * time.Minute).Truncate(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.Second == 0 {
+		t = t.Add(1 * time.Second)
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
+// dayMatches reports whether t satisfies s's Dom and Dow fields, applying
+// the standard cron rule: if either field is restricted (not "*"), a match
+// on that field alone is enough; if both are unrestricted, both trivially
+// match anyway.
+func dayMatches(s *SpecSchedule, t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.Dom > 0
+	dowMatch := 1<<uint(t.Weekday())&s.Dow > 0
+	if s.Dom&starBit > 0 || s.Dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// ConstantDelaySchedule is a Schedule that fires every Delay, as produced
+// by an "@every <duration>" descriptor. It doesn't support delays under a
+// second.
+type ConstantDelaySchedule struct {
+	Delay time.Duration
+}
+
+// Next returns the next time this schedule is activated, greater than the
+// given time.
+func (s ConstantDelaySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.Delay - time.Duration(t.Nanosecond())*time.Nanosecond)
+}
@@ -0,0 +1,95 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseEveryMinute covers the five-field default parser matching a
+// wildcard spec against every minute.
+func TestParseEveryMinute(t *testing.T) {
+	sched, err := Parse("* * * * *")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	from := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 1, 12, 31, 0, 0, time.UTC)
+	if got := sched.Next(from); !got.Equal(want) {
+		t.Fatalf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+// TestParseSpecificFields covers a fully-specified spec landing on the
+// exact next matching minute/hour/day/month/weekday.
+func TestParseSpecificFields(t *testing.T) {
+	sched, err := Parse("30 9 * * MON")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// 2024-01-01 is a Monday.
+	from := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 8, 9, 30, 0, 0, time.UTC)
+	if got := sched.Next(from); !got.Equal(want) {
+		t.Fatalf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+// TestParseRejectsWrongFieldCount covers the default parser's field-count
+// validation: a six-field seconds spec isn't valid without WithSeconds.
+func TestParseRejectsWrongFieldCount(t *testing.T) {
+	if _, err := Parse("* * * * * *"); err == nil {
+		t.Fatal("Parse of a six-field spec succeeded under the five-field default parser")
+	}
+}
+
+// TestParseEveryDescriptor covers the "@every <duration>" descriptor
+// producing a ConstantDelaySchedule.
+func TestParseEveryDescriptor(t *testing.T) {
+	sched, err := Parse("@every 1h30m")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := from.Add(90 * time.Minute)
+	if got := sched.Next(from); !got.Equal(want) {
+		t.Fatalf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+// TestParseDailyDescriptor covers "@daily" resolving to midnight every day.
+func TestParseDailyDescriptor(t *testing.T) {
+	sched, err := Parse("@daily")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	from := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := sched.Next(from); !got.Equal(want) {
+		t.Fatalf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
+
+// TestNewParserWithSecondsRequiresSixFields covers a Parser built with
+// Second|Minute|Hour|Dom|Month|Dow rejecting a five-field spec.
+func TestNewParserWithSecondsRequiresSixFields(t *testing.T) {
+	p := NewParser(Second | Minute | Hour | Dom | Month | Dow)
+
+	if _, err := p.Parse("* * * * *"); err == nil {
+		t.Fatal("Parse of a five-field spec succeeded under a six-field Parser")
+	}
+	if _, err := p.Parse("* * * * * *"); err != nil {
+		t.Fatalf("Parse of a six-field spec failed: %v", err)
+	}
+}
+
+// TestParseUnrecognizedDescriptorErrors covers an unknown "@foo" descriptor
+// returning an error instead of a nil Schedule.
+func TestParseUnrecognizedDescriptorErrors(t *testing.T) {
+	if _, err := Parse("@foo"); err == nil {
+		t.Fatal("Parse of an unrecognized descriptor succeeded, want an error")
+	}
+}
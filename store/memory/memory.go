@@ -0,0 +1,66 @@
+// Package memory provides an in-process cron.Store backed by a
+// mutex-guarded map. It does not survive process restarts, so it is
+// primarily useful for tests and for exercising Cron's missed-run
+// reconciliation; a real deployment wanting crash recovery across restarts
+// should implement cron.Store against durable storage instead (a database,
+// bolt, etc).
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wiwii/cron"
+)
+
+// Store is an in-memory implementation of cron.Store.
+type Store struct {
+	mu      sync.Mutex
+	entries map[cron.EntryID]cron.StoredEntry
+}
+
+// New returns a ready-to-use in-memory Store.
+func New() *Store {
+	return &Store{entries: make(map[cron.EntryID]cron.StoredEntry)}
+}
+
+// Save implements cron.Store.
+func (s *Store) Save(entry cron.StoredEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[entry.Id] = entry
+	return nil
+}
+
+// Load implements cron.Store.
+func (s *Store) Load() ([]cron.StoredEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]cron.StoredEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+// Delete implements cron.Store.
+func (s *Store) Delete(id cron.EntryID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, id)
+	return nil
+}
+
+// UpdateRun implements cron.Store.
+func (s *Store) UpdateRun(id cron.EntryID, prev, next time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[id]
+	if !ok {
+		return nil
+	}
+	e.Prev = prev
+	e.Next = next
+	s.entries[id] = e
+	return nil
+}
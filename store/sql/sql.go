@@ -0,0 +1,102 @@
+// Package sql provides a database/sql-backed cron.Store, usable with any
+// driver (sqlite, postgres, mysql, ...) the caller has already registered
+// and connected. It targets "?" positional placeholders (sqlite/mysql);
+// callers on a driver that requires numbered placeholders (e.g. postgres'
+// $1, $2, ...) should rewrite the queries below for their driver.
+package sql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/wiwii/cron"
+)
+
+// schema is the table InitSchema creates. It's deliberately minimal
+// (no indexes beyond the primary key) since Store is meant for the common
+// single-process-per-schedule case Load scans in full on Start.
+const schema = `
+CREATE TABLE IF NOT EXISTS cron_entries (
+	id          INTEGER PRIMARY KEY,
+	spec        TEXT NOT NULL,
+	job_name    TEXT NOT NULL,
+	prev        TIMESTAMP,
+	next        TIMESTAMP,
+	arg_len     INTEGER NOT NULL,
+	tag         TEXT NOT NULL,
+	task        TEXT NOT NULL,
+	params      TEXT NOT NULL,
+	leader_only BOOLEAN NOT NULL,
+	leader_key  TEXT NOT NULL
+)`
+
+// Store is a cron.Store backed by a SQL database via database/sql.
+type Store struct {
+	DB *sql.DB
+}
+
+// New returns a Store backed by db. Call InitSchema once (or apply an
+// equivalent migration of your own) before first use.
+func New(db *sql.DB) *Store {
+	return &Store{DB: db}
+}
+
+// InitSchema creates the cron_entries table if it doesn't already exist.
+func (s *Store) InitSchema() error {
+	_, err := s.DB.Exec(schema)
+	return err
+}
+
+// Save implements cron.Store. It replaces any existing row for entry.Id
+// with a delete-then-insert, rather than an upsert, so it doesn't depend
+// on a driver-specific ON CONFLICT/ON DUPLICATE KEY syntax.
+func (s *Store) Save(entry cron.StoredEntry) error {
+	if err := s.Delete(entry.Id); err != nil {
+		return err
+	}
+	_, err := s.DB.Exec(
+		`INSERT INTO cron_entries
+			(id, spec, job_name, prev, next, arg_len, tag, task, params, leader_only, leader_key)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.Id, entry.Spec, entry.JobName, entry.Prev, entry.Next,
+		entry.ArgLen, entry.Tag, entry.Task, entry.Params, entry.LeaderOnly, entry.LeaderKey,
+	)
+	return err
+}
+
+// Load implements cron.Store.
+func (s *Store) Load() ([]cron.StoredEntry, error) {
+	rows, err := s.DB.Query(
+		`SELECT id, spec, job_name, prev, next, arg_len, tag, task, params, leader_only, leader_key
+		 FROM cron_entries`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []cron.StoredEntry
+	for rows.Next() {
+		var se cron.StoredEntry
+		if err := rows.Scan(
+			&se.Id, &se.Spec, &se.JobName, &se.Prev, &se.Next,
+			&se.ArgLen, &se.Tag, &se.Task, &se.Params, &se.LeaderOnly, &se.LeaderKey,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, se)
+	}
+	return out, rows.Err()
+}
+
+// Delete implements cron.Store.
+func (s *Store) Delete(id cron.EntryID) error {
+	_, err := s.DB.Exec(`DELETE FROM cron_entries WHERE id = ?`, id)
+	return err
+}
+
+// UpdateRun implements cron.Store.
+func (s *Store) UpdateRun(id cron.EntryID, prev, next time.Time) error {
+	_, err := s.DB.Exec(`UPDATE cron_entries SET prev = ?, next = ? WHERE id = ?`, prev, next, id)
+	return err
+}
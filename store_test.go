@@ -0,0 +1,140 @@
+package cron_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wiwii/cron"
+	"github.com/wiwii/cron/store/memory"
+)
+
+type noopJob struct{ runs int }
+
+func (j *noopJob) Run(...interface{}) { j.runs++ }
+
+// denyCoordinator is a cron.Coordinator that counts Acquire calls and
+// always refuses the claim, so tests can assert a tick went through
+// Coordinator arbitration without actually letting the job run.
+type denyCoordinator struct{ acquires int32 }
+
+func (d *denyCoordinator) Acquire(context.Context, string, time.Duration) (bool, func(), error) {
+	atomic.AddInt32(&d.acquires, 1)
+	return false, nil, nil
+}
+
+// TestAddStoredJobThenStartDoesNotDuplicateEntry reproduces the usual
+// RegisterJobFactory -> AddStoredJob -> Start call order: AddStoredJob
+// already pushes the entry onto the live heap, so Start's loadFromStore
+// must not push a second copy under the same EntryID.
+func TestAddStoredJobThenStartDoesNotDuplicateEntry(t *testing.T) {
+	job := &noopJob{}
+	st := memory.New()
+	c := cron.New(cron.WithStore(st))
+	c.RegisterJobFactory("noop", func() cron.Job { return job })
+
+	if err := c.AddStoredJob("@every 1h", "noop"); err != nil {
+		t.Fatalf("AddStoredJob: %v", err)
+	}
+	if got := len(c.Entries()); got != 1 {
+		t.Fatalf("Entries() before Start = %d, want 1", got)
+	}
+
+	c.Start()
+	defer c.Stop()
+
+	if got := len(c.Entries()); got != 1 {
+		t.Fatalf("Entries() after Start = %d, want 1 (loadFromStore duplicated the live entry)", got)
+	}
+
+	entries := c.Entries()
+	c.Remove(entries[0].Id)
+	if got := len(c.Entries()); got != 0 {
+		t.Fatalf("Entries() after Remove = %d, want 0 (an orphan duplicate survived)", got)
+	}
+}
+
+// TestLoadFromStoreNeverRunNotTreatedAsMissed covers a persisted entry
+// that hasn't run yet (Prev is the zero time, as AddStoredJob leaves it).
+// schedule.Next(zero time) must not be used to decide the entry is overdue.
+func TestLoadFromStoreNeverRunNotTreatedAsMissed(t *testing.T) {
+	st := memory.New()
+	if err := st.Save(cron.StoredEntry{
+		Id:      1,
+		Spec:    "@every 1m",
+		JobName: "noop",
+	}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	job := &noopJob{}
+	c := cron.New(cron.WithStore(st), cron.WithMissedRunPolicy(cron.RunAllMissed))
+	c.RegisterJobFactory("noop", func() cron.Job { return job })
+
+	done := make(chan struct{})
+	go func() {
+		c.Start()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Start did not return within 3s; a never-run entry was treated as missed since year 1")
+	}
+	defer c.Stop()
+
+	if job.runs != 0 {
+		t.Fatalf("job ran %d times on load, want 0 (never-run entry is not a missed run)", job.runs)
+	}
+}
+
+// TestRunMissedGoesThroughCoordinator covers a LeaderOnly entry with a
+// missed run: runMissed must arbitrate through the Coordinator like any
+// other dispatch, instead of calling startJob directly and letting every
+// replica run the catch-up job regardless of leadership.
+func TestRunMissedGoesThroughCoordinator(t *testing.T) {
+	st := memory.New()
+	if err := st.Save(cron.StoredEntry{
+		Id:         1,
+		Spec:       "@every 1m",
+		Prev:       time.Now().Add(-1 * time.Hour),
+		JobName:    "noop",
+		LeaderOnly: true,
+	}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	job := &noopJob{}
+	coord := &denyCoordinator{}
+	c := cron.New(
+		cron.WithStore(st),
+		cron.WithMissedRunPolicy(cron.RunOnceOnStartup),
+		cron.WithCoordinator(coord),
+	)
+	c.RegisterJobFactory("noop", func() cron.Job { return job })
+
+	done := make(chan struct{})
+	go func() {
+		c.Start()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Start did not return within 3s")
+	}
+	defer c.Stop()
+
+	// RunOnceOnStartup dispatches its catch-up run in a goroutine; give it
+	// a moment to reach the Coordinator before asserting.
+	time.Sleep(100 * time.Millisecond)
+
+	if atomic.LoadInt32(&coord.acquires) == 0 {
+		t.Fatal("runMissed never called Coordinator.Acquire")
+	}
+	if job.runs != 0 {
+		t.Fatalf("job ran %d times despite losing leadership, want 0", job.runs)
+	}
+}